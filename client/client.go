@@ -0,0 +1,328 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// RequestInterceptor 在请求发送前执行，可用于签名、日志、埋点等场景
+type RequestInterceptor func(req *http.Request) error
+
+// ResponseInterceptor 在响应返回后执行，可用于日志、指标采集等场景
+type ResponseInterceptor func(resp *http.Response) error
+
+// Client 是可配置的 HTTP 客户端，通过建造者模式逐步配置后使用
+type Client struct {
+	baseURL       string
+	headers       map[string]string
+	timeout       time.Duration
+	proxyURL      *url.URL
+	tlsConfig     *tls.Config
+	retryCount    int
+	retryInterval time.Duration
+
+	requestInterceptors  []RequestInterceptor
+	responseInterceptors []ResponseInterceptor
+
+	httpClientOnce sync.Once
+	httpClient     *http.Client
+}
+
+// NewClient 创建一个空配置的 Client，默认超时时间为 30 秒
+func NewClient() *Client {
+	return &Client{
+		headers: make(map[string]string),
+		timeout: 30 * time.Second,
+	}
+}
+
+// SetBaseURL 设置请求的基础地址，后续 Get/Post 等方法传入的 url 会拼接在其后
+func (c *Client) SetBaseURL(baseURL string) *Client {
+	c.baseURL = baseURL
+	return c
+}
+
+// SetHeaders 设置默认请求头，会与单次请求传入的请求头合并（单次请求优先）
+func (c *Client) SetHeaders(headers map[string]string) *Client {
+	c.headers = headers
+	return c
+}
+
+// SetProxy 设置代理地址，proxyURL 形如 http://127.0.0.1:8080
+func (c *Client) SetProxy(proxyURL string) *Client {
+	parsed, err := url.Parse(proxyURL)
+	if err != nil {
+		return c
+	}
+	c.proxyURL = parsed
+	return c
+}
+
+// SetTLSConfig 设置自定义 TLS 配置，用于 mTLS、跳过证书校验等场景
+func (c *Client) SetTLSConfig(cfg *tls.Config) *Client {
+	c.tlsConfig = cfg
+	return c
+}
+
+// SetRetry 设置重试次数与重试间隔，对网络错误、5xx、429 响应生效；
+// 请求体为含 Streams 内存流的 FileForm 时不重试
+func (c *Client) SetRetry(n int, interval time.Duration) *Client {
+	c.retryCount = n
+	c.retryInterval = interval
+	return c
+}
+
+// Timeout 设置请求超时时间
+func (c *Client) Timeout(d time.Duration) *Client {
+	c.timeout = d
+	return c
+}
+
+// AddRequestInterceptor 追加一个请求拦截器，按添加顺序依次执行
+func (c *Client) AddRequestInterceptor(fn RequestInterceptor) *Client {
+	c.requestInterceptors = append(c.requestInterceptors, fn)
+	return c
+}
+
+// AddResponseInterceptor 追加一个响应拦截器，按添加顺序依次执行
+func (c *Client) AddResponseInterceptor(fn ResponseInterceptor) *Client {
+	c.responseInterceptors = append(c.responseInterceptors, fn)
+	return c
+}
+
+// getHTTPClient 返回底层 http.Client，首次调用时根据当前配置构建并缓存，
+// 以复用连接池/keep-alive；每次请求的超时改由 context 控制，而非重建 Client
+func (c *Client) getHTTPClient() *http.Client {
+	c.httpClientOnce.Do(func() {
+		transport := &http.Transport{}
+
+		if c.proxyURL != nil {
+			transport.Proxy = http.ProxyURL(c.proxyURL)
+		}
+		if c.tlsConfig != nil {
+			transport.TLSClientConfig = c.tlsConfig
+		}
+
+		c.httpClient = &http.Client{Transport: transport}
+	})
+
+	return c.httpClient
+}
+
+// mergeHeaders 将 Client 的默认请求头与单次请求的请求头合并，单次请求优先
+func (c *Client) mergeHeaders(headers map[string]string) map[string]string {
+	merged := make(map[string]string, len(c.headers)+len(headers))
+	for k, v := range c.headers {
+		merged[k] = v
+	}
+	for k, v := range headers {
+		merged[k] = v
+	}
+	return merged
+}
+
+// isRetryableStatus 判断响应状态码是否属于应当重试的瞬时错误
+func isRetryableStatus(statusCode int) bool {
+	return statusCode >= 500 || statusCode == http.StatusTooManyRequests
+}
+
+// hasUnbufferedStream 判断请求体是否包含只能读取一次的内存流，这类请求体在重试时
+// 会被上一次尝试耗尽，因此不能安全重试
+func hasUnbufferedStream(body interface{}) bool {
+	form, ok := body.(FileForm)
+	return ok && len(form.Streams) > 0
+}
+
+// doRequest 执行一次带拦截器链与重试的请求
+func (c *Client) doRequest(ctx context.Context, options RequestOptions) (*Response, error) {
+	options.URL = c.baseURL + options.URL
+	options.Headers = c.mergeHeaders(options.Headers)
+
+	timeout := options.Timeout
+	if timeout == 0 {
+		timeout = c.timeout
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	httpClient := c.getHTTPClient()
+
+	attempts := c.retryCount + 1
+	if hasUnbufferedStream(options.Body) {
+		// FormFileStream 的 io.Reader 只能消费一次，重试会上传空文件且不报错，故禁用重试
+		attempts = 1
+	}
+
+	var lastErr error
+
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(c.retryInterval):
+			}
+		}
+
+		resp, err := c.doOnce(ctx, httpClient, options)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if isRetryableStatus(resp.StatusCode) && attempt < attempts-1 {
+			lastErr = fmt.Errorf("响应状态码 %d 可重试", resp.StatusCode)
+			continue
+		}
+		return resp, nil
+	}
+
+	return nil, fmt.Errorf("请求失败，已重试 %d 次: %v", attempts-1, lastErr)
+}
+
+// doOnce 执行单次请求，负责编码请求体、运行拦截器链
+func (c *Client) doOnce(ctx context.Context, httpClient *http.Client, options RequestOptions) (*Response, error) {
+	requestURL, err := applyParams(options.URL, options.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := encodeBody(options.Body, &options.Headers)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, options.Method, requestURL, body)
+	if err != nil {
+		return nil, fmt.Errorf("创建请求失败: %v", err)
+	}
+
+	for key, value := range options.Headers {
+		req.Header.Set(key, value)
+	}
+
+	for _, interceptor := range c.requestInterceptors {
+		if err := interceptor(req); err != nil {
+			return nil, fmt.Errorf("请求拦截器执行失败: %v", err)
+		}
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("发送请求失败: %v", err)
+	}
+	defer resp.Body.Close()
+
+	for _, interceptor := range c.responseInterceptors {
+		if err := interceptor(resp); err != nil {
+			return nil, fmt.Errorf("响应拦截器执行失败: %v", err)
+		}
+	}
+
+	return toResponse(resp)
+}
+
+// Get 发起 GET 请求，url 会拼接在 baseURL 之后
+func (c *Client) Get(url string, headers ...map[string]string) (*Response, error) {
+	return c.GetCtx(context.Background(), url, headers...)
+}
+
+// GetCtx 是支持 context 的 GET 请求
+func (c *Client) GetCtx(ctx context.Context, url string, headers ...map[string]string) (*Response, error) {
+	options := RequestOptions{Method: "GET", URL: url}
+	if len(headers) > 0 {
+		options.Headers = headers[0]
+	}
+	return c.doRequest(ctx, options)
+}
+
+// GetWithParams 发起带查询参数的 GET 请求，url 会拼接在 baseURL 之后
+func (c *Client) GetWithParams(url string, params map[string]interface{}, headers ...map[string]string) (*Response, error) {
+	return c.GetWithParamsCtx(context.Background(), url, params, headers...)
+}
+
+// GetWithParamsCtx 是支持 context 的 GetWithParams
+func (c *Client) GetWithParamsCtx(ctx context.Context, url string, params map[string]interface{}, headers ...map[string]string) (*Response, error) {
+	options := RequestOptions{Method: "GET", URL: url, Params: params}
+	if len(headers) > 0 {
+		options.Headers = headers[0]
+	}
+	return c.doRequest(ctx, options)
+}
+
+// Post 发起 POST 请求，url 会拼接在 baseURL 之后
+func (c *Client) Post(url string, body interface{}, headers ...map[string]string) (*Response, error) {
+	return c.PostCtx(context.Background(), url, body, headers...)
+}
+
+// PostCtx 是支持 context 的 POST 请求
+func (c *Client) PostCtx(ctx context.Context, url string, body interface{}, headers ...map[string]string) (*Response, error) {
+	options := RequestOptions{Method: "POST", URL: url, Body: body}
+	if len(headers) > 0 {
+		options.Headers = headers[0]
+	}
+	return c.doRequest(ctx, options)
+}
+
+// Put 发起 PUT 请求，url 会拼接在 baseURL 之后
+func (c *Client) Put(url string, body interface{}, headers ...map[string]string) (*Response, error) {
+	return c.PutCtx(context.Background(), url, body, headers...)
+}
+
+// PutCtx 是支持 context 的 PUT 请求
+func (c *Client) PutCtx(ctx context.Context, url string, body interface{}, headers ...map[string]string) (*Response, error) {
+	options := RequestOptions{Method: "PUT", URL: url, Body: body}
+	if len(headers) > 0 {
+		options.Headers = headers[0]
+	}
+	return c.doRequest(ctx, options)
+}
+
+// Delete 发起 DELETE 请求，url 会拼接在 baseURL 之后
+func (c *Client) Delete(url string, headers ...map[string]string) (*Response, error) {
+	return c.DeleteCtx(context.Background(), url, headers...)
+}
+
+// DeleteCtx 是支持 context 的 DELETE 请求
+func (c *Client) DeleteCtx(ctx context.Context, url string, headers ...map[string]string) (*Response, error) {
+	options := RequestOptions{Method: "DELETE", URL: url}
+	if len(headers) > 0 {
+		options.Headers = headers[0]
+	}
+	return c.doRequest(ctx, options)
+}
+
+// Patch 发起 PATCH 请求，url 会拼接在 baseURL 之后
+func (c *Client) Patch(url string, body interface{}, headers ...map[string]string) (*Response, error) {
+	return c.PatchCtx(context.Background(), url, body, headers...)
+}
+
+// PatchCtx 是支持 context 的 PATCH 请求
+func (c *Client) PatchCtx(ctx context.Context, url string, body interface{}, headers ...map[string]string) (*Response, error) {
+	options := RequestOptions{Method: "PATCH", URL: url, Body: body}
+	if len(headers) > 0 {
+		options.Headers = headers[0]
+	}
+	return c.doRequest(ctx, options)
+}
+
+// Head 发起 HEAD 请求，url 会拼接在 baseURL 之后
+func (c *Client) Head(url string, headers ...map[string]string) (*Response, error) {
+	return c.HeadCtx(context.Background(), url, headers...)
+}
+
+// HeadCtx 是支持 context 的 HEAD 请求
+func (c *Client) HeadCtx(ctx context.Context, url string, headers ...map[string]string) (*Response, error) {
+	options := RequestOptions{Method: "HEAD", URL: url}
+	if len(headers) > 0 {
+		options.Headers = headers[0]
+	}
+	return c.doRequest(ctx, options)
+}
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClientRetrySucceedsAfterTransientErrors(t *testing.T) {
+	var attempts int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt64(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := NewClient().SetRetry(3, time.Millisecond)
+
+	resp, err := c.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("got status %d, want 200", resp.StatusCode)
+	}
+	if got := atomic.LoadInt64(&attempts); got != 3 {
+		t.Fatalf("got %d attempts, want 3", got)
+	}
+}
+
+func TestClientRetryExhaustedReturnsLastResponse(t *testing.T) {
+	var attempts int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := NewClient().SetRetry(2, time.Millisecond)
+
+	// 重试耗尽后仍返回最后一次的响应（而非 Go error），调用方需自行检查状态码
+	resp, err := c.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("got status %d, want 503", resp.StatusCode)
+	}
+	if got := atomic.LoadInt64(&attempts); got != 3 {
+		t.Fatalf("got %d attempts, want 3 (1 initial + 2 retries)", got)
+	}
+}
+
+func TestClientRetryBackoffHonorsContextCancellation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	// 重试间隔故意设置得很长，验证 ctx 取消能立即打断退避等待，而不是阻塞到 retryInterval 结束
+	c := NewClient().SetRetry(5, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+	}()
+
+	start := time.Now()
+	_, err := c.GetCtx(ctx, server.URL)
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("expected error from cancelled context, got nil")
+	}
+	if elapsed > time.Second {
+		t.Fatalf("backoff did not honor context cancellation, took %v", elapsed)
+	}
+}
@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// JSON 将响应体反序列化为 v，v 应为指针类型
+func (r *Response) JSON(v interface{}) error {
+	return json.Unmarshal(r.Body, v)
+}
+
+// XML 将响应体反序列化为 v，v 应为指针类型
+func (r *Response) XML(v interface{}) error {
+	return xml.Unmarshal(r.Body, v)
+}
+
+// StreamResponse 是流式响应，Body 需由调用方读取并负责 Close
+type StreamResponse struct {
+	StatusCode int               // 状态码
+	Headers    map[string]string // 响应头
+	Body       io.ReadCloser     // 响应体，调用方读取后需自行关闭
+}
+
+// RequestStream 以流式方式发起请求，不会将响应体读入内存，适合大文件下载
+func RequestStream(options RequestOptions) (*StreamResponse, error) {
+	return RequestStreamCtx(context.Background(), options)
+}
+
+// RequestStreamCtx 是支持 context 的 RequestStream
+func RequestStreamCtx(ctx context.Context, options RequestOptions) (*StreamResponse, error) {
+	// 流式响应由调用方控制读取节奏，不对整个请求设置超时，依赖 ctx 取消
+	client := &http.Client{}
+
+	requestURL, err := applyParams(options.URL, options.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := encodeBody(options.Body, &options.Headers)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, options.Method, requestURL, body)
+	if err != nil {
+		return nil, fmt.Errorf("创建请求失败: %v", err)
+	}
+
+	for key, value := range options.Headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("发送请求失败: %v", err)
+	}
+
+	headers := make(map[string]string)
+	for key, values := range resp.Header {
+		if len(values) > 0 {
+			headers[key] = values[0]
+		}
+	}
+
+	return &StreamResponse{
+		StatusCode: resp.StatusCode,
+		Headers:    headers,
+		Body:       resp.Body,
+	}, nil
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// FormFileStream 表示一个基于内存流的文件上传字段，适用于没有本地文件路径的场景
+type FormFileStream struct {
+	Filename string    // 上传时使用的文件名
+	Reader   io.Reader // 文件内容
+}
+
+// FileForm 描述一个 multipart/form-data 表单
+type FileForm struct {
+	Fields  map[string]string         // 普通表单字段
+	Files   map[string]string         // 字段名 -> 文件路径，从磁盘读取
+	Streams map[string]FormFileStream // 字段名 -> 内存流，用于无本地文件的上传
+}
+
+// encodeMultipart 将 FileForm 编码为 multipart/form-data 请求体，并设置带 boundary 的 Content-Type
+func encodeMultipart(form FileForm, headers *map[string]string) (io.Reader, error) {
+	buf := &bytes.Buffer{}
+	writer := multipart.NewWriter(buf)
+
+	for field, value := range form.Fields {
+		if err := writer.WriteField(field, value); err != nil {
+			return nil, fmt.Errorf("写入表单字段失败: %v", err)
+		}
+	}
+
+	for field, path := range form.Files {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("打开上传文件失败: %v", err)
+		}
+
+		part, err := writer.CreateFormFile(field, filepath.Base(path))
+		if err != nil {
+			file.Close()
+			return nil, fmt.Errorf("创建表单文件字段失败: %v", err)
+		}
+		if _, err := io.Copy(part, file); err != nil {
+			file.Close()
+			return nil, fmt.Errorf("写入上传文件失败: %v", err)
+		}
+		file.Close()
+	}
+
+	for field, stream := range form.Streams {
+		part, err := writer.CreateFormFile(field, stream.Filename)
+		if err != nil {
+			return nil, fmt.Errorf("创建表单文件字段失败: %v", err)
+		}
+		if _, err := io.Copy(part, stream.Reader); err != nil {
+			return nil, fmt.Errorf("写入上传流失败: %v", err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("关闭 multipart writer 失败: %v", err)
+	}
+
+	if *headers == nil {
+		*headers = make(map[string]string)
+	}
+	(*headers)["Content-Type"] = writer.FormDataContentType()
+
+	return buf, nil
+}
+
+// PostForm 发送 application/x-www-form-urlencoded 请求
+func PostForm(requestURL string, values url.Values, headers ...map[string]string) (*Response, error) {
+	return PostFormCtx(context.Background(), requestURL, values, headers...)
+}
+
+// PostFormCtx 是支持 context 的 PostForm
+func PostFormCtx(ctx context.Context, requestURL string, values url.Values, headers ...map[string]string) (*Response, error) {
+	options := RequestOptions{
+		Method: "POST",
+		URL:    requestURL,
+		Body:   values,
+	}
+
+	if len(headers) > 0 {
+		options.Headers = headers[0]
+	}
+
+	return requestCtx(ctx, options)
+}
+
+// PostMultipart 发送 multipart/form-data 请求，支持磁盘文件与内存流两种上传方式
+func PostMultipart(requestURL string, form FileForm, headers ...map[string]string) (*Response, error) {
+	return PostMultipartCtx(context.Background(), requestURL, form, headers...)
+}
+
+// PostMultipartCtx 是支持 context 的 PostMultipart
+func PostMultipartCtx(ctx context.Context, requestURL string, form FileForm, headers ...map[string]string) (*Response, error) {
+	options := RequestOptions{
+		Method: "POST",
+		URL:    requestURL,
+		Body:   form,
+	}
+
+	if len(headers) > 0 {
+		options.Headers = headers[0]
+	}
+
+	return requestCtx(ctx, options)
+}
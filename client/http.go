@@ -2,20 +2,23 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"time"
 )
 
 // RequestOptions 请求配置选项
 type RequestOptions struct {
-	Method  string            // HTTP 方法
-	URL     string            // 请求 URL
-	Headers map[string]string // 请求头
-	Body    interface{}       // 请求体
-	Timeout time.Duration     // 超时时间
+	Method  string                 // HTTP 方法
+	URL     string                 // 请求 URL
+	Headers map[string]string      // 请求头
+	Body    interface{}            // 请求体
+	Timeout time.Duration          // 超时时间
+	Params  map[string]interface{} // 查询参数，会拼接到 URL 上
 }
 
 // Response 响应结构体
@@ -26,8 +29,65 @@ type Response struct {
 	Text       string            // 响应文本
 }
 
-// 基准 request 函数
+// 基准 request 函数，默认使用 context.Background()
 func request(options RequestOptions) (*Response, error) {
+	return requestCtx(context.Background(), options)
+}
+
+// Do 使用自定义 RequestOptions 发起请求，供需要完全控制请求配置的调用方使用（如压测子包）
+func Do(options RequestOptions) (*Response, error) {
+	return request(options)
+}
+
+// DoCtx 是支持 context 的 Do
+func DoCtx(ctx context.Context, options RequestOptions) (*Response, error) {
+	return requestCtx(ctx, options)
+}
+
+// encodeBody 根据请求体类型编码为 io.Reader，并按需补全 headers 中的 Content-Type
+func encodeBody(v interface{}, headers *map[string]string) (io.Reader, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	switch v := v.(type) {
+	case string:
+		return bytes.NewBufferString(v), nil
+	case []byte:
+		return bytes.NewBuffer(v), nil
+	case url.Values:
+		// application/x-www-form-urlencoded
+		if *headers == nil {
+			*headers = make(map[string]string)
+		}
+		if _, exists := (*headers)["Content-Type"]; !exists {
+			(*headers)["Content-Type"] = "application/x-www-form-urlencoded"
+		}
+		return bytes.NewBufferString(v.Encode()), nil
+	case FileForm:
+		// multipart/form-data
+		return encodeMultipart(v, headers)
+	default:
+		// JSON 序列化
+		jsonData, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("序列化请求体失败: %v", err)
+		}
+
+		// 自动设置 Content-Type 为 JSON
+		if *headers == nil {
+			*headers = make(map[string]string)
+		}
+		if _, exists := (*headers)["Content-Type"]; !exists {
+			(*headers)["Content-Type"] = "application/json"
+		}
+
+		return bytes.NewBuffer(jsonData), nil
+	}
+}
+
+// 基准 requestCtx 函数，支持通过 context 取消请求或传递截止时间
+func requestCtx(ctx context.Context, options RequestOptions) (*Response, error) {
 	// 设置默认超时时间
 	if options.Timeout == 0 {
 		options.Timeout = 30 * time.Second
@@ -38,35 +98,18 @@ func request(options RequestOptions) (*Response, error) {
 		Timeout: options.Timeout,
 	}
 
-	var body io.Reader
-
-	// 处理请求体
-	if options.Body != nil {
-		switch v := options.Body.(type) {
-		case string:
-			body = bytes.NewBufferString(v)
-		case []byte:
-			body = bytes.NewBuffer(v)
-		default:
-			// JSON 序列化
-			jsonData, err := json.Marshal(v)
-			if err != nil {
-				return nil, fmt.Errorf("序列化请求体失败: %v", err)
-			}
-			body = bytes.NewBuffer(jsonData)
-
-			// 自动设置 Content-Type 为 JSON
-			if options.Headers == nil {
-				options.Headers = make(map[string]string)
-			}
-			if _, exists := options.Headers["Content-Type"]; !exists {
-				options.Headers["Content-Type"] = "application/json"
-			}
-		}
+	requestURL, err := applyParams(options.URL, options.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := encodeBody(options.Body, &options.Headers)
+	if err != nil {
+		return nil, err
 	}
 
-	// 创建请求
-	req, err := http.NewRequest(options.Method, options.URL, body)
+	// 创建请求，context 用于取消请求或传递截止时间
+	req, err := http.NewRequestWithContext(ctx, options.Method, requestURL, body)
 	if err != nil {
 		return nil, fmt.Errorf("创建请求失败: %v", err)
 	}
@@ -83,6 +126,11 @@ func request(options RequestOptions) (*Response, error) {
 	}
 	defer resp.Body.Close()
 
+	return toResponse(resp)
+}
+
+// toResponse 读取 http.Response 并转换为本包的 Response 结构体
+func toResponse(resp *http.Response) (*Response, error) {
 	// 读取响应体
 	responseBody, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -107,6 +155,11 @@ func request(options RequestOptions) (*Response, error) {
 
 // GET 请求
 func Get(url string, headers ...map[string]string) (*Response, error) {
+	return GetCtx(context.Background(), url, headers...)
+}
+
+// GetCtx 是支持 context 的 GET 请求，可用于取消请求或设置截止时间
+func GetCtx(ctx context.Context, url string, headers ...map[string]string) (*Response, error) {
 	options := RequestOptions{
 		Method: "GET",
 		URL:    url,
@@ -116,11 +169,16 @@ func Get(url string, headers ...map[string]string) (*Response, error) {
 		options.Headers = headers[0]
 	}
 
-	return request(options)
+	return requestCtx(ctx, options)
 }
 
 // POST 请求
 func Post(url string, body interface{}, headers ...map[string]string) (*Response, error) {
+	return PostCtx(context.Background(), url, body, headers...)
+}
+
+// PostCtx 是支持 context 的 POST 请求，可用于取消请求或设置截止时间
+func PostCtx(ctx context.Context, url string, body interface{}, headers ...map[string]string) (*Response, error) {
 	options := RequestOptions{
 		Method: "POST",
 		URL:    url,
@@ -131,11 +189,16 @@ func Post(url string, body interface{}, headers ...map[string]string) (*Response
 		options.Headers = headers[0]
 	}
 
-	return request(options)
+	return requestCtx(ctx, options)
 }
 
 // PUT 请求
 func Put(url string, body interface{}, headers ...map[string]string) (*Response, error) {
+	return PutCtx(context.Background(), url, body, headers...)
+}
+
+// PutCtx 是支持 context 的 PUT 请求，可用于取消请求或设置截止时间
+func PutCtx(ctx context.Context, url string, body interface{}, headers ...map[string]string) (*Response, error) {
 	options := RequestOptions{
 		Method: "PUT",
 		URL:    url,
@@ -146,11 +209,16 @@ func Put(url string, body interface{}, headers ...map[string]string) (*Response,
 		options.Headers = headers[0]
 	}
 
-	return request(options)
+	return requestCtx(ctx, options)
 }
 
 // DELETE 请求
 func Delete(url string, headers ...map[string]string) (*Response, error) {
+	return DeleteCtx(context.Background(), url, headers...)
+}
+
+// DeleteCtx 是支持 context 的 DELETE 请求，可用于取消请求或设置截止时间
+func DeleteCtx(ctx context.Context, url string, headers ...map[string]string) (*Response, error) {
 	options := RequestOptions{
 		Method: "DELETE",
 		URL:    url,
@@ -160,11 +228,16 @@ func Delete(url string, headers ...map[string]string) (*Response, error) {
 		options.Headers = headers[0]
 	}
 
-	return request(options)
+	return requestCtx(ctx, options)
 }
 
 // PATCH 请求
 func Patch(url string, body interface{}, headers ...map[string]string) (*Response, error) {
+	return PatchCtx(context.Background(), url, body, headers...)
+}
+
+// PatchCtx 是支持 context 的 PATCH 请求，可用于取消请求或设置截止时间
+func PatchCtx(ctx context.Context, url string, body interface{}, headers ...map[string]string) (*Response, error) {
 	options := RequestOptions{
 		Method: "PATCH",
 		URL:    url,
@@ -175,11 +248,16 @@ func Patch(url string, body interface{}, headers ...map[string]string) (*Respons
 		options.Headers = headers[0]
 	}
 
-	return request(options)
+	return requestCtx(ctx, options)
 }
 
 // HEAD 请求
 func Head(url string, headers ...map[string]string) (*Response, error) {
+	return HeadCtx(context.Background(), url, headers...)
+}
+
+// HeadCtx 是支持 context 的 HEAD 请求，可用于取消请求或设置截止时间
+func HeadCtx(ctx context.Context, url string, headers ...map[string]string) (*Response, error) {
 	options := RequestOptions{
 		Method: "HEAD",
 		URL:    url,
@@ -189,7 +267,7 @@ func Head(url string, headers ...map[string]string) (*Response, error) {
 		options.Headers = headers[0]
 	}
 
-	return request(options)
+	return requestCtx(ctx, options)
 }
 
 // 示例函数 - 演示如何使用 HTTP 请求库
@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+)
+
+// applyParams 将 params 编码后拼接到 rawURL 的查询字符串中，支持字符串、数字、布尔值及其切片
+func applyParams(rawURL string, params map[string]interface{}) (string, error) {
+	if len(params) == 0 {
+		return rawURL, nil
+	}
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("解析 URL 失败: %v", err)
+	}
+
+	query := parsed.Query()
+
+	// 按 key 排序，保证同一组 params 每次生成的查询字符串一致
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		for _, value := range paramValues(params[key]) {
+			query.Add(key, value)
+		}
+	}
+
+	parsed.RawQuery = query.Encode()
+	return parsed.String(), nil
+}
+
+// paramValues 将单个 params 值渲染为字符串列表，任意切片/数组都会被展开为多个同名参数
+func paramValues(v interface{}) []string {
+	rv := reflect.ValueOf(v)
+
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		values := make([]string, 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			values = append(values, fmt.Sprint(rv.Index(i).Interface()))
+		}
+		return values
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
+
+// GetWithParams 发起带查询参数的 GET 请求
+func GetWithParams(requestURL string, params map[string]interface{}, headers ...map[string]string) (*Response, error) {
+	return GetWithParamsCtx(context.Background(), requestURL, params, headers...)
+}
+
+// GetWithParamsCtx 是支持 context 的 GetWithParams
+func GetWithParamsCtx(ctx context.Context, requestURL string, params map[string]interface{}, headers ...map[string]string) (*Response, error) {
+	options := RequestOptions{
+		Method: "GET",
+		URL:    requestURL,
+		Params: params,
+	}
+
+	if len(headers) > 0 {
+		options.Headers = headers[0]
+	}
+
+	return requestCtx(ctx, options)
+}
@@ -0,0 +1,223 @@
+// Package stress 基于 client 包提供并发压力测试能力
+package stress
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"ovaphlow/module-general/client"
+)
+
+// ValidationRule 描述一条响应校验规则
+type ValidationRule struct {
+	StatusCode int         // 期望的状态码，0 表示不校验
+	JSONPath   string      // 以 "." 分隔的 JSON 路径，如 "data.id"，为空表示不校验
+	JSONEquals interface{} // JSONPath 对应值的期望结果
+}
+
+// Config 是一次压测任务的配置
+type Config struct {
+	Concurrency   int                     // 并发数
+	TotalRequests int                     // 总请求数，0 表示改用 Duration 控制压测时长
+	Duration      time.Duration           // 压测时长，仅在 TotalRequests 为 0 时生效
+	Targets       []client.RequestOptions // 压测目标，可配置多个以模拟混合负载
+	Rules         []ValidationRule        // 响应校验规则，全部满足才算成功
+}
+
+// Progress 是压测过程中的实时进度
+type Progress struct {
+	Completed int     // 已完成请求数
+	Success   int     // 成功数
+	Failed    int     // 失败数
+	QPS       float64 // 当前平均 QPS
+}
+
+// Result 是压测结束后的汇总结果
+type Result struct {
+	Total        int
+	Success      int
+	Failed       int
+	QPS          float64
+	LatencyP50   time.Duration
+	LatencyP90   time.Duration
+	LatencyP95   time.Duration
+	LatencyP99   time.Duration
+	StatusCounts map[int]int    // 状态码 -> 次数
+	ErrorCounts  map[string]int // 错误信息 -> 次数
+}
+
+// Run 按照 cfg 执行压测，progress 非空时会持续推送实时进度，调用方负责消费直到被关闭
+func Run(cfg Config, progress chan<- Progress) (*Result, error) {
+	if len(cfg.Targets) == 0 {
+		return nil, fmt.Errorf("至少需要一个压测目标")
+	}
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = 1
+	}
+
+	var (
+		mu           sync.Mutex
+		latencies    []time.Duration
+		statusCounts = make(map[int]int)
+		errorCounts  = make(map[string]int)
+		successCount int64
+		failedCount  int64
+		requestCount int64
+	)
+
+	start := time.Now()
+	deadline := start.Add(cfg.Duration)
+	sem := make(chan struct{}, cfg.Concurrency)
+
+	var wg sync.WaitGroup
+	var index int
+
+	for {
+		if cfg.TotalRequests > 0 && int(atomic.LoadInt64(&requestCount)) >= cfg.TotalRequests {
+			break
+		}
+		if cfg.TotalRequests == 0 && time.Now().After(deadline) {
+			break
+		}
+
+		sem <- struct{}{}
+		target := cfg.Targets[index%len(cfg.Targets)]
+		index++
+		atomic.AddInt64(&requestCount, 1)
+
+		wg.Add(1)
+		go func(target client.RequestOptions) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			reqStart := time.Now()
+			resp, err := client.Do(target)
+			latency := time.Since(reqStart)
+
+			if err == nil {
+				err = validate(resp, cfg.Rules)
+			}
+
+			mu.Lock()
+			latencies = append(latencies, latency)
+			if resp != nil {
+				statusCounts[resp.StatusCode]++
+			}
+			if err != nil {
+				errorCounts[err.Error()]++
+			}
+			mu.Unlock()
+
+			if err != nil {
+				atomic.AddInt64(&failedCount, 1)
+			} else {
+				atomic.AddInt64(&successCount, 1)
+			}
+
+			if progress != nil {
+				success := atomic.LoadInt64(&successCount)
+				failed := atomic.LoadInt64(&failedCount)
+				completed := success + failed
+				elapsed := time.Since(start).Seconds()
+				qps := 0.0
+				if elapsed > 0 {
+					qps = float64(completed) / elapsed
+				}
+				progress <- Progress{
+					Completed: int(completed),
+					Success:   int(success),
+					Failed:    int(failed),
+					QPS:       qps,
+				}
+			}
+		}(target)
+	}
+
+	wg.Wait()
+	if progress != nil {
+		close(progress)
+	}
+
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	elapsed := time.Since(start).Seconds()
+	qps := 0.0
+	if elapsed > 0 {
+		qps = float64(len(latencies)) / elapsed
+	}
+
+	return &Result{
+		Total:        len(latencies),
+		Success:      int(successCount),
+		Failed:       int(failedCount),
+		QPS:          qps,
+		LatencyP50:   percentile(latencies, 50),
+		LatencyP90:   percentile(latencies, 90),
+		LatencyP95:   percentile(latencies, 95),
+		LatencyP99:   percentile(latencies, 99),
+		StatusCounts: statusCounts,
+		ErrorCounts:  errorCounts,
+	}, nil
+}
+
+// percentile 返回已排序 latencies 中第 p 百分位的延迟
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := p * len(sorted) / 100
+	if idx >= len(sorted) {
+		idx = len(sorted) - 1
+	}
+	return sorted[idx]
+}
+
+// validate 按 rules 校验响应，任意一条不满足即返回错误
+func validate(resp *client.Response, rules []ValidationRule) error {
+	if resp == nil {
+		return fmt.Errorf("响应为空")
+	}
+
+	for _, rule := range rules {
+		if rule.StatusCode != 0 && resp.StatusCode != rule.StatusCode {
+			return fmt.Errorf("状态码不匹配: 期望 %d 实际 %d", rule.StatusCode, resp.StatusCode)
+		}
+		if rule.JSONPath != "" {
+			value, err := lookupJSONPath(resp.Body, rule.JSONPath)
+			if err != nil {
+				return err
+			}
+			if !reflect.DeepEqual(value, rule.JSONEquals) {
+				return fmt.Errorf("JSON 路径 %s 的值不匹配: 期望 %v 实际 %v", rule.JSONPath, rule.JSONEquals, value)
+			}
+		}
+	}
+
+	return nil
+}
+
+// lookupJSONPath 按 "." 分隔的路径在响应 JSON 中查找值
+func lookupJSONPath(body []byte, path string) (interface{}, error) {
+	var data interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil, fmt.Errorf("解析响应 JSON 失败: %v", err)
+	}
+
+	for _, key := range strings.Split(path, ".") {
+		m, ok := data.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("JSON 路径 %s 不存在", path)
+		}
+		data, ok = m[key]
+		if !ok {
+			return nil, fmt.Errorf("JSON 路径 %s 不存在", path)
+		}
+	}
+
+	return data, nil
+}
@@ -0,0 +1,106 @@
+package stress
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"ovaphlow/module-general/client"
+)
+
+func TestPercentile(t *testing.T) {
+	sorted := []time.Duration{
+		10 * time.Millisecond,
+		20 * time.Millisecond,
+		30 * time.Millisecond,
+		40 * time.Millisecond,
+		50 * time.Millisecond,
+	}
+
+	cases := []struct {
+		p    int
+		want time.Duration
+	}{
+		{0, 10 * time.Millisecond},
+		{50, 30 * time.Millisecond},
+		{90, 50 * time.Millisecond},
+		{100, 50 * time.Millisecond},
+	}
+
+	for _, c := range cases {
+		if got := percentile(sorted, c.p); got != c.want {
+			t.Errorf("percentile(sorted, %d) = %v, want %v", c.p, got, c.want)
+		}
+	}
+
+	if got := percentile(nil, 50); got != 0 {
+		t.Errorf("percentile(nil, 50) = %v, want 0", got)
+	}
+}
+
+// TestRunProgressReflectsFinishedNotIssued 复现并固定一个回归：Progress.Completed/QPS
+// 必须反映"已完成"的请求数，而不是"已派发"的请求数。通过让 N 个请求同时在途、
+// 逐个释放，验证每条进度消息的 Completed 严格按完成顺序递增，而不是一开始就等于并发数。
+func TestRunProgressReflectsFinishedNotIssued(t *testing.T) {
+	const total = 3
+
+	arrived := make(chan struct{}, total)
+	release := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		arrived <- struct{}{}
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cfg := Config{
+		Concurrency:   total,
+		TotalRequests: total,
+		Targets: []client.RequestOptions{
+			{Method: "GET", URL: server.URL},
+		},
+	}
+
+	progress := make(chan Progress)
+	resultCh := make(chan *Result, 1)
+	go func() {
+		result, err := Run(cfg, progress)
+		if err != nil {
+			t.Errorf("Run returned error: %v", err)
+		}
+		resultCh <- result
+	}()
+
+	// 等待全部 3 个请求同时在途，此时已派发数已经是 3，但完成数应为 0
+	for i := 0; i < total; i++ {
+		select {
+		case <-arrived:
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out waiting for request %d to arrive", i+1)
+		}
+	}
+
+	for want := 1; want <= total; want++ {
+		release <- struct{}{}
+
+		select {
+		case p := <-progress:
+			if p.Completed != want {
+				t.Fatalf("progress update reported Completed=%d, want %d (issuance count would have been %d)", p.Completed, want, total)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out waiting for progress update %d", want)
+		}
+	}
+
+	select {
+	case result := <-resultCh:
+		if result.Total != total || result.Success != total {
+			t.Fatalf("got Result{Total: %d, Success: %d}, want both %d", result.Total, result.Success, total)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for Run to finish")
+	}
+}
@@ -0,0 +1,112 @@
+// Command stress 是基于 client/stress 子包的命令行压测工具
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"ovaphlow/module-general/client"
+	"ovaphlow/module-general/client/stress"
+)
+
+// headerValues 收集通过 -H 重复指定的请求头
+type headerValues []string
+
+func (h *headerValues) String() string {
+	return strings.Join(*h, ",")
+}
+
+func (h *headerValues) Set(value string) error {
+	*h = append(*h, value)
+	return nil
+}
+
+func (h headerValues) toMap() map[string]string {
+	headers := make(map[string]string)
+	for _, item := range h {
+		parts := strings.SplitN(item, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		headers[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	return headers
+}
+
+func main() {
+	concurrency := flag.Int("c", 10, "并发数")
+	totalRequests := flag.Int("n", 0, "总请求数，为 0 时改用 -z 指定的压测时长")
+	duration := flag.Duration("z", 10*time.Second, "压测时长，仅在 -n 为 0 时生效")
+	method := flag.String("m", "GET", "HTTP 方法")
+	targetURL := flag.String("url", "", "目标 URL")
+	body := flag.String("body", "", "请求体")
+	expectStatus := flag.Int("status", 0, "期望的状态码，为 0 时不校验")
+
+	var headers headerValues
+	flag.Var(&headers, "H", "请求头，格式为 Key:Value，可重复指定")
+	flag.Parse()
+
+	if *targetURL == "" {
+		fmt.Fprintln(os.Stderr, "必须通过 -url 指定目标地址")
+		os.Exit(1)
+	}
+
+	options := client.RequestOptions{
+		Method:  strings.ToUpper(*method),
+		URL:     *targetURL,
+		Headers: headers.toMap(),
+	}
+	if *body != "" {
+		options.Body = *body
+	}
+
+	var rules []stress.ValidationRule
+	if *expectStatus != 0 {
+		rules = append(rules, stress.ValidationRule{StatusCode: *expectStatus})
+	}
+
+	cfg := stress.Config{
+		Concurrency:   *concurrency,
+		TotalRequests: *totalRequests,
+		Duration:      *duration,
+		Targets:       []client.RequestOptions{options},
+		Rules:         rules,
+	}
+
+	progress := make(chan stress.Progress, 100)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for p := range progress {
+			fmt.Printf("\r已完成 %d 次请求，成功 %d，失败 %d，QPS %.2f", p.Completed, p.Success, p.Failed, p.QPS)
+		}
+		fmt.Println()
+	}()
+
+	result, err := stress.Run(cfg, progress)
+	<-done
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "压测执行失败: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("总请求数: %d\n", result.Total)
+	fmt.Printf("成功: %d 失败: %d\n", result.Success, result.Failed)
+	fmt.Printf("QPS: %.2f\n", result.QPS)
+	fmt.Printf("P50: %v P90: %v P95: %v P99: %v\n", result.LatencyP50, result.LatencyP90, result.LatencyP95, result.LatencyP99)
+
+	fmt.Println("状态码分布:")
+	for status, count := range result.StatusCounts {
+		fmt.Printf("  %d: %d\n", status, count)
+	}
+
+	if len(result.ErrorCounts) > 0 {
+		fmt.Println("错误分布:")
+		for msg, count := range result.ErrorCounts {
+			fmt.Printf("  %s: %d\n", msg, count)
+		}
+	}
+}